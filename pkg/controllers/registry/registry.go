@@ -0,0 +1,107 @@
+// Package registry provides a plugin-style registry that controller packages (has,
+// jvmbuildservice, ...) register themselves against at init() time, replacing the
+// hand-wired NewSuiteControler-per-package pattern. Validate constructs and health-checks every
+// registered plugin up front, so a missing CRD or a broken constructor is caught before any test
+// runs instead of at first use deep inside a spec.
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	kubeCl "github.com/redhat-appstudio/e2e-tests/pkg/apis/kubernetes"
+
+	. "github.com/onsi/ginkgo/v2"
+)
+
+// Controller is the minimal lifecycle every registered controller must provide once constructed.
+type Controller interface {
+	// HealthCheck verifies the controller's required CRDs/resources are reachable on the cluster.
+	HealthCheck(ctx context.Context) error
+}
+
+// SuiteController is what a controller package registers with the registry. Name identifies the
+// plugin (e.g. "has", "jvmbuildservice"); New constructs the controller against the cluster client.
+type SuiteController interface {
+	Name() string
+	New(kube *kubeCl.CustomClient) (Controller, error)
+}
+
+var (
+	mu        sync.Mutex
+	plugins   = map[string]SuiteController{}
+	instances = map[string]Controller{}
+)
+
+// Register adds a SuiteController plugin. Intended to be called from a controller package's
+// init() function. Panics on a duplicate name: two packages registering under the same identity
+// is a wiring bug that should fail immediately at program start, not be silently overwritten.
+func Register(plugin SuiteController) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := plugins[plugin.Name()]; exists {
+		panic(fmt.Sprintf("registry: controller %q already registered", plugin.Name()))
+	}
+	plugins[plugin.Name()] = plugin
+}
+
+// Validate constructs every registered plugin against kube and runs its HealthCheck, collecting
+// every failure instead of stopping at the first one, so a broken suite reports everything wrong
+// with it in one pass. On success, the constructed controllers become available through Get.
+func Validate(ctx context.Context, kube *kubeCl.CustomClient) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var errs []error
+	for name, plugin := range plugins {
+		controller, err := plugin.New(kube)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("controller %q: %w", name, err))
+			continue
+		}
+		if err := controller.HealthCheck(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("controller %q failed health check: %w", name, err))
+			continue
+		}
+		instances[name] = controller
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("registry validation failed: %v", errs)
+	}
+	return nil
+}
+
+// ValidateOrFail calls Validate and, on error, fails the current Ginkgo spec run with ginkgo.Fail
+// instead of returning the error. Intended to be called once from the suite's BeforeSuite, e.g.
+//
+//	var _ = BeforeSuite(func() {
+//	    registry.ValidateOrFail(context.Background(), kubeClient)
+//	})
+//
+// so a missing CRD or broken plugin constructor stops the suite before any spec runs.
+func ValidateOrFail(ctx context.Context, kube *kubeCl.CustomClient) {
+	if err := Validate(ctx, kube); err != nil {
+		Fail(err.Error())
+	}
+}
+
+// Get returns the validated instance registered under name, type-asserted to T. Must be called
+// after Validate has run; typically T is the controller package's concrete *XController type.
+func Get[T Controller](name string) (T, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var zero T
+	controller, ok := instances[name]
+	if !ok {
+		return zero, fmt.Errorf("registry: controller %q was not registered or failed validation", name)
+	}
+	typed, ok := controller.(T)
+	if !ok {
+		return zero, fmt.Errorf("registry: controller %q is not of the requested type", name)
+	}
+	return typed, nil
+}
@@ -0,0 +1,102 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	kubeCl "github.com/redhat-appstudio/e2e-tests/pkg/apis/kubernetes"
+)
+
+type fakeController struct {
+	healthErr error
+}
+
+func (c *fakeController) HealthCheck(ctx context.Context) error {
+	return c.healthErr
+}
+
+type fakePlugin struct {
+	name      string
+	newErr    error
+	healthErr error
+}
+
+func (p fakePlugin) Name() string {
+	return p.name
+}
+
+func (p fakePlugin) New(kube *kubeCl.CustomClient) (Controller, error) {
+	if p.newErr != nil {
+		return nil, p.newErr
+	}
+	return &fakeController{healthErr: p.healthErr}, nil
+}
+
+// resetRegistry clears package-level state between tests, since Register/Validate/Get all operate
+// on shared maps.
+func resetRegistry() {
+	mu.Lock()
+	defer mu.Unlock()
+	plugins = map[string]SuiteController{}
+	instances = map[string]Controller{}
+}
+
+func TestRegisterPanicsOnDuplicateName(t *testing.T) {
+	resetRegistry()
+	defer resetRegistry()
+
+	Register(fakePlugin{name: "dup"})
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Register to panic on a duplicate name")
+		}
+	}()
+	Register(fakePlugin{name: "dup"})
+}
+
+func TestValidateAndGet(t *testing.T) {
+	resetRegistry()
+	defer resetRegistry()
+
+	Register(fakePlugin{name: "ok"})
+
+	if err := Validate(context.Background(), nil); err != nil {
+		t.Fatalf("expected Validate to succeed, got %v", err)
+	}
+
+	if _, err := Get[*fakeController]("ok"); err != nil {
+		t.Errorf("expected Get to find the validated controller, got %v", err)
+	}
+
+	if _, err := Get[*fakeController]("missing"); err == nil {
+		t.Error("expected Get to fail for an unregistered name")
+	}
+}
+
+func TestValidateAggregatesFailures(t *testing.T) {
+	resetRegistry()
+	defer resetRegistry()
+
+	Register(fakePlugin{name: "broken-new", newErr: errBoom})
+	Register(fakePlugin{name: "broken-health", healthErr: errBoom})
+	Register(fakePlugin{name: "fine"})
+
+	err := Validate(context.Background(), nil)
+	if err == nil {
+		t.Fatal("expected Validate to report the broken plugins")
+	}
+
+	if _, getErr := Get[*fakeController]("fine"); getErr != nil {
+		t.Errorf("expected the healthy plugin to still be available, got %v", getErr)
+	}
+	if _, getErr := Get[*fakeController]("broken-new"); getErr == nil {
+		t.Error("expected broken-new to not be available after a failed Validate")
+	}
+}
+
+var errBoom = &boomError{}
+
+type boomError struct{}
+
+func (*boomError) Error() string { return "boom" }
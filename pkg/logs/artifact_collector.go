@@ -0,0 +1,262 @@
+package logs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	. "github.com/redhat-appstudio/e2e-tests/pkg/utils"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/common"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/k8sretry"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/tekton"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+)
+
+// ManifestEntry describes a single file written into an artifact bundle, so a manifest.json index
+// can point at a resource's dumped YAML/logs by kind/name/UID without anyone walking the directory.
+type ManifestEntry struct {
+	Path      string `json:"path"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	UID       string `json:"uid,omitempty"`
+	Phase     string `json:"phase,omitempty"`
+}
+
+// ResourceDumper is implemented by controller packages that know how to collect their own
+// resources into an artifact bundle (e.g. has dumping Applications/Components,
+// jvmbuildservice dumping JBSConfigs). Each controller package registers a dumper with the
+// ArtifactCollector so it doesn't need to hard-code knowledge of every resource kind in the suite.
+//
+// tekton and common provide no ResourceDumper; ArtifactCollector calls them directly for pod and
+// PipelineRun logs. There is no Environment/Snapshot/Release dumper, so those resources are not
+// part of the bundle.
+type ResourceDumper interface {
+	// Name identifies the dumper in log output, e.g. "has" or "jvmbuildservice".
+	Name() string
+	// Dump writes this dumper's resources for testNamespace under dir and returns the manifest
+	// entries describing what it wrote.
+	Dump(testNamespace, dir string) ([]ManifestEntry, error)
+}
+
+// ArtifactCollector builds a structured, per-namespace artifact bundle under
+// $ARTIFACT_DIR/<testNamespace>/: pod logs, PipelineRun/TaskRun YAML and step logs, resources
+// contributed by registered ResourceDumpers, recent Events sorted by LastTimestamp, and a
+// manifest.json index tying it all together. The bundle is also gzip-tarred for CI upload.
+type ArtifactCollector struct {
+	cs      *common.SuiteController
+	tekton  *tekton.SuiteController
+	dumpers []ResourceDumper
+}
+
+// NewArtifactCollector creates an ArtifactCollector wired to the given controllers. Additional
+// ResourceDumpers can be registered with RegisterDumper before calling CollectAll.
+func NewArtifactCollector(cs *common.SuiteController, t *tekton.SuiteController, dumpers ...ResourceDumper) *ArtifactCollector {
+	return &ArtifactCollector{cs: cs, tekton: t, dumpers: dumpers}
+}
+
+// RegisterDumper adds a ResourceDumper that will run on every subsequent CollectAll call.
+func (a *ArtifactCollector) RegisterDumper(d ResourceDumper) {
+	a.dumpers = append(a.dumpers, d)
+}
+
+// CollectAll dumps the full artifact bundle for testNamespace under
+// $ARTIFACT_DIR/<testNamespace>/ and gzip-tars the resulting directory to
+// $ARTIFACT_DIR/<testNamespace>.tar.gz. A failure collecting one piece of the bundle (pod logs,
+// a dumper, events) is logged and does not stop the rest of the bundle from being collected.
+func (a *ArtifactCollector) CollectAll(testNamespace, jobName string) error {
+	wd, _ := os.Getwd()
+	artifactDir := GetEnv("ARTIFACT_DIR", fmt.Sprintf("%s/tmp", wd))
+	bundleDir := filepath.Join(artifactDir, testNamespace)
+
+	if err := os.MkdirAll(bundleDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	var manifest []ManifestEntry
+
+	if err := a.cs.StorePodLogs(testNamespace, jobName, bundleDir); err != nil {
+		GinkgoWriter.Printf("Failed to store pod logs: %s", err)
+	}
+
+	previousLogEntries, err := a.dumpPreviousContainerLogs(testNamespace, bundleDir)
+	if err != nil {
+		GinkgoWriter.Printf("Failed to store previous container logs: %s", err)
+	}
+	manifest = append(manifest, previousLogEntries...)
+
+	if err := a.tekton.StorePipelineRuns(testNamespace, a.cs); err != nil {
+		GinkgoWriter.Printf("Failed to store pipelineRun logs: %s", err)
+	}
+
+	entries, err := a.dumpEvents(testNamespace, bundleDir)
+	if err != nil {
+		GinkgoWriter.Printf("Failed to store events: %s", err)
+	}
+	manifest = append(manifest, entries...)
+
+	for _, dumper := range a.dumpers {
+		dumperEntries, err := dumper.Dump(testNamespace, bundleDir)
+		if err != nil {
+			GinkgoWriter.Printf("Resource dumper %q failed: %s", dumper.Name(), err)
+			continue
+		}
+		manifest = append(manifest, dumperEntries...)
+	}
+
+	if err := writeManifest(bundleDir, manifest); err != nil {
+		GinkgoWriter.Printf("Failed to write artifact manifest: %s", err)
+	}
+
+	if err := tarGzDir(bundleDir, bundleDir+".tar.gz"); err != nil {
+		GinkgoWriter.Printf("Failed to archive artifact bundle for %s: %s", testNamespace, err)
+	}
+
+	return nil
+}
+
+// dumpPreviousContainerLogs writes the logs of any previously-terminated container in
+// testNamespace (RestartCount > 0) under previous-container-logs/, so a crash loop's last run is
+// visible alongside its current logs.
+func (a *ArtifactCollector) dumpPreviousContainerLogs(testNamespace, dir string) ([]ManifestEntry, error) {
+	var pods *corev1.PodList
+	if err := k8sretry.Do(fmt.Sprintf("listing pods in %s", testNamespace), func() error {
+		var listErr error
+		pods, listErr = a.cs.KubeInterface().CoreV1().Pods(testNamespace).List(context.TODO(), metav1.ListOptions{})
+		return listErr
+	}); err != nil {
+		return nil, err
+	}
+
+	var manifest []ManifestEntry
+	logsDir := filepath.Join(dir, "previous-container-logs")
+
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.RestartCount == 0 {
+				continue
+			}
+
+			var data []byte
+			err := k8sretry.Do(fmt.Sprintf("fetching previous logs for %s/%s", pod.Name, containerStatus.Name), func() error {
+				var getErr error
+				data, getErr = a.cs.KubeInterface().CoreV1().Pods(testNamespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+					Container: containerStatus.Name,
+					Previous:  true,
+				}).DoRaw(context.TODO())
+				return getErr
+			})
+			if err != nil {
+				GinkgoWriter.Printf("Failed to get previous logs for %s/%s: %s", pod.Name, containerStatus.Name, err)
+				continue
+			}
+
+			if err := os.MkdirAll(logsDir, os.ModePerm); err != nil {
+				return manifest, err
+			}
+
+			fileName := fmt.Sprintf("%s_%s_previous.log", pod.Name, containerStatus.Name)
+			if err := os.WriteFile(filepath.Join(logsDir, fileName), data, os.ModePerm); err != nil {
+				return manifest, err
+			}
+
+			manifest = append(manifest, ManifestEntry{
+				Path:      filepath.Join("previous-container-logs", fileName),
+				Kind:      "Pod",
+				Name:      pod.Name,
+				Namespace: testNamespace,
+				UID:       string(pod.UID),
+				Phase:     string(pod.Status.Phase),
+			})
+		}
+	}
+
+	return manifest, nil
+}
+
+// dumpEvents writes all Events in testNamespace, sorted by LastTimestamp, to events.json.
+func (a *ArtifactCollector) dumpEvents(testNamespace, dir string) ([]ManifestEntry, error) {
+	var events *corev1.EventList
+	if err := k8sretry.Do(fmt.Sprintf("listing events in %s", testNamespace), func() error {
+		var listErr error
+		events, listErr = a.cs.KubeInterface().CoreV1().Events(testNamespace).List(context.TODO(), metav1.ListOptions{})
+		return listErr
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events.Items, func(i, j int) bool {
+		return events.Items[i].LastTimestamp.Before(&events.Items[j].LastTimestamp)
+	})
+
+	path := filepath.Join(dir, "events.json")
+	data, err := json.MarshalIndent(events.Items, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, data, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	return []ManifestEntry{{Path: "events.json", Kind: "EventList", Name: testNamespace, Namespace: testNamespace}}, nil
+}
+
+// writeManifest writes the manifest.json index for the bundle in dir.
+func writeManifest(dir string, entries []ManifestEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "manifest.json"), data, os.ModePerm)
+}
+
+// tarGzDir writes a gzip-compressed tarball of srcDir to destFile.
+func tarGzDir(srcDir, destFile string) error {
+	f, err := os.Create(destFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	defer gz.Close()
+
+	tw := tar.NewWriter(gz)
+	defer tw.Close()
+
+	return filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(filepath.Dir(srcDir), path)
+		if err != nil {
+			return err
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = rel
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(data)
+		return err
+	})
+}
@@ -1,32 +1,17 @@
 package logs
 
 import (
-	"fmt"
-	"os"
-
-	. "github.com/redhat-appstudio/e2e-tests/pkg/utils"
 	"github.com/redhat-appstudio/e2e-tests/pkg/utils/common"
 	"github.com/redhat-appstudio/e2e-tests/pkg/utils/tekton"
-
-	. "github.com/onsi/ginkgo/v2"
 )
 
-func StoreTestLogs(testNamespace, jobName string, cs *common.SuiteController, t *tekton.SuiteController) error {
-	wd, _ := os.Getwd()
-	artifactDir := GetEnv("ARTIFACT_DIR", fmt.Sprintf("%s/tmp", wd))
-	testLogsDir := fmt.Sprintf("%s/%s", artifactDir, testNamespace)
-
-	if err := os.MkdirAll(testLogsDir, os.ModePerm); err != nil {
-		return err
-	}
-
-	if err := cs.StorePodLogs(testNamespace, jobName, testLogsDir); err != nil {
-		GinkgoWriter.Printf("Failed to store pod logs: %s", err)
-	}
-
-	if err := t.StorePipelineRuns(testNamespace, cs); err != nil {
-		GinkgoWriter.Printf("Failed to store pipelineRun logs: %s", err)
-	}
-
-	return nil
+// StoreTestLogs collects the full artifact bundle for testNamespace under
+// $ARTIFACT_DIR/<testNamespace> by running ArtifactCollector.CollectAll: pod and pipelineRun logs,
+// recent Events, a manifest.json index and a gzip tarball of the bundle. Any extra ResourceDumpers
+// passed in (e.g. has.NewResourceDumper) are registered with the underlying ArtifactCollector, so a
+// controller package's own resources end up in the same bundle.
+//
+// Deprecated: construct a logs.ArtifactCollector directly and call CollectAll.
+func StoreTestLogs(testNamespace, jobName string, cs *common.SuiteController, t *tekton.SuiteController, dumpers ...ResourceDumper) error {
+	return NewArtifactCollector(cs, t, dumpers...).CollectAll(testNamespace, jobName)
 }
@@ -7,9 +7,12 @@ import (
 
 	appservice "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	"github.com/redhat-appstudio/e2e-tests/pkg/utils"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/k8sretry"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/timeouts"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	rclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -22,25 +25,39 @@ type ApplicationsInterface interface {
 	// Given a devfile content determine if a gitops repository was created in GitHub.
 	ApplicationGitopsRepoExists(devfileContent string) wait.ConditionFunc
 
+	// Waits up to timeouts.Config.GitopsRepoReady for application-service to create a GitOps
+	// repository in GitHub for the given devfile content.
+	WaitForApplicationGitopsRepo(devfileContent string, opts ...timeouts.Option) error
+
 	// Creates an application object in the kubernetes cluster.
 	CreateApplication(name string, namespace string) (*appservice.Application, error)
 
-	// Creates an application object in the kubernetes cluster and wait for a period of given timeout.
-	CreateApplicationWithTimeout(name string, namespace string, timeout time.Duration) (*appservice.Application, error)
+	// Creates an application object in the kubernetes cluster, waiting up to timeout for its devfile
+	// to be populated. The Create() call itself is bounded separately by timeouts.Config.AppCreate,
+	// which opts can override for this call; the devfile wait always uses timeout.
+	CreateApplicationWithTimeout(name string, namespace string, timeout time.Duration, opts ...timeouts.Option) (*appservice.Application, error)
 
 	// Deletes an application object from the kubernetes cluster.
-	DeleteApplication(name string, namespace string, reportErrorOnNotFound bool) error
+	DeleteApplication(name string, namespace string, reportErrorOnNotFound bool, opts ...DeleteOption) error
+
+	// Deletes all applications from the given namespace in the kubernetes cluster, waiting up to
+	// timeout (or the AppDelete passed through WithTimeout) for the whole batch to be gone.
+	DeleteAllApplicationsInASpecificNamespace(namespace string, timeout time.Duration, opts ...DeleteOption) error
 
-	// Deletes all applications from the given namespace in the kubernetes cluster.
-	DeleteAllApplicationsInASpecificNamespace(namespace string, timeout time.Duration) error
+	// Waits until every Application tracked in the given UIDMap is gone or was recreated with a new UID.
+	WaitForApplicationsDeleted(uidMap UIDMap, timeout time.Duration) error
 }
 
+// UIDMap associates the namespaced name of an Application with the UID it had at the moment its
+// deletion was requested, so a batch of deletes can be waited on together instead of one-by-one.
+type UIDMap map[types.NamespacedName]types.UID
+
 // GetApplication returns an application given a name and namespace from kubernetes cluster.
 func (h *hasFactory) GetApplication(name string, namespace string) (*appservice.Application, error) {
 	application := appservice.Application{
 		Spec: appservice.ApplicationSpec{},
 	}
-	if err := h.KubeRest().Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, &application); err != nil {
+	if err := k8sretry.GetK8sObjectWithRetry(context.TODO(), h.KubeRest(), types.NamespacedName{Name: name, Namespace: namespace}, &application); err != nil {
 		return nil, err
 	}
 
@@ -67,13 +84,27 @@ func (s *hasFactory) ApplicationGitopsRepoExists(devfileContent string) wait.Con
 	}
 }
 
-// CreateApplication creates an application in the kubernetes cluster with 10 minutes default time for creation.
+// WaitForApplicationGitopsRepo waits up to timeouts.Config.GitopsRepoReady for application-service
+// to create a GitOps repository in GitHub for the given devfile content. The default, sourced from
+// E2E_TIMEOUT_GITOPS_REPO_READY, can be overridden for this call with timeouts.WithGitopsRepoReady.
+func (h *hasFactory) WaitForApplicationGitopsRepo(devfileContent string, opts ...timeouts.Option) error {
+	cfg := timeouts.Apply(timeouts.NewConfig(), opts...)
+	return utils.WaitUntil(h.ApplicationGitopsRepoExists(devfileContent), cfg.GitopsRepoReady)
+}
+
+// CreateApplication creates an application in the kubernetes cluster, waiting up to
+// timeouts.Config.DevfileReady (10 minutes by default) for its devfile to be populated.
 func (h *hasFactory) CreateApplication(name string, namespace string) (*appservice.Application, error) {
-	return h.CreateApplicationWithTimeout(name, namespace, time.Minute*10)
+	return h.CreateApplicationWithTimeout(name, namespace, timeouts.NewConfig().DevfileReady)
 }
 
-// CreateHasApplicationWithTimeout creates an application in the kubernetes cluster with a custom default time for creation.
-func (h *hasFactory) CreateApplicationWithTimeout(name string, namespace string, timeout time.Duration) (*appservice.Application, error) {
+// CreateApplicationWithTimeout creates an application in the kubernetes cluster and waits up to
+// timeout for its devfile to be populated. The Create() call itself is separately bounded by
+// timeouts.Config.AppCreate, sourced from E2E_TIMEOUT_APP_CREATE and overridable for this call via
+// timeouts.WithAppCreate.
+func (h *hasFactory) CreateApplicationWithTimeout(name string, namespace string, timeout time.Duration, opts ...timeouts.Option) (*appservice.Application, error) {
+	cfg := timeouts.Apply(timeouts.NewConfig(), opts...)
+
 	application := &appservice.Application{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -84,7 +115,10 @@ func (h *hasFactory) CreateApplicationWithTimeout(name string, namespace string,
 		},
 	}
 
-	if err := h.KubeRest().Create(context.TODO(), application); err != nil {
+	createCtx, cancel := context.WithTimeout(context.Background(), cfg.AppCreate)
+	defer cancel()
+
+	if err := k8sretry.CreateK8sObjectWithRetry(createCtx, h.KubeRest(), application); err != nil {
 		return nil, err
 	}
 
@@ -99,41 +133,113 @@ func (h *hasFactory) CreateApplicationWithTimeout(name string, namespace string,
 // DeleteApplication delete a HAS Application resource from the namespace.
 // Optionally, it can avoid returning an error if the resource did not exist:
 // - specify 'false', if it's likely the Application has already been deleted (for example, because the Namespace was deleted)
-func (h *hasFactory) DeleteApplication(name string, namespace string, reportErrorOnNotFound bool) error {
+// Additional PostDeleteHooks can be registered via WithPostDelete; they run once the Application is
+// confirmed gone from the cluster.
+func (h *hasFactory) DeleteApplication(name string, namespace string, reportErrorOnNotFound bool, opts ...DeleteOption) error {
 	application := appservice.Application{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
 		},
 	}
-	if err := h.KubeRest().Delete(context.TODO(), &application); err != nil {
+
+	// Capture the last-known state before issuing the delete: the UID guards against a fast
+	// recreate under the same name/namespace, and the full object is what post-delete hooks see,
+	// since by the time they run the object itself is gone from the API server.
+	var uid types.UID
+	if existing, err := h.GetApplication(name, namespace); err == nil {
+		uid = existing.UID
+		application = *existing
+	}
+
+	if err := k8sretry.DeleteK8sObjectWithRetry(context.TODO(), h.KubeRest(), &application); err != nil {
 		if !k8sErrors.IsNotFound(err) || (k8sErrors.IsNotFound(err) && reportErrorOnNotFound) {
 			return fmt.Errorf("error deleting an application: %+v", err)
 		}
 	}
-	return utils.WaitUntil(h.ApplicationDeleted(&application), 1*time.Minute)
+
+	options := newDeleteOptions(opts)
+	cfg := timeouts.Apply(timeouts.NewConfig(), options.timeoutOpts...)
+
+	deleteErr := utils.WaitUntil(h.ApplicationDeleted(&application, uid), cfg.AppDelete)
+
+	var hookErr error
+	if deleteErr == nil {
+		hookErr = runPostDeleteHooks(h, &application, options.postDeleteHooks)
+	}
+
+	return combineDeleteAndHookErrors(deleteErr, hookErr)
 }
 
 // ApplicationDeleted check if a given application object was deleted successfully from the kubernetes cluster.
-func (h *hasFactory) ApplicationDeleted(application *appservice.Application) wait.ConditionFunc {
+// Deletion is only considered complete when the object is NotFound or the object fetched by
+// name/namespace has a different UID than the one captured at delete time, mirroring the
+// `kubectl wait --for=delete` semantics instead of treating any NotFound as success.
+func (h *hasFactory) ApplicationDeleted(application *appservice.Application, uid types.UID) wait.ConditionFunc {
 	return func() (bool, error) {
-		_, err := h.GetApplication(application.Name, application.Namespace)
-		return err != nil && k8sErrors.IsNotFound(err), nil
+		app, err := h.GetApplication(application.Name, application.Namespace)
+		if err != nil {
+			return k8sErrors.IsNotFound(err), nil
+		}
+		return uid != "" && app.UID != uid, nil
 	}
 }
 
-// DeleteAllApplicationsInASpecificNamespace removes all application CRs from a specific namespace. Useful when creating a lot of resources and want to remove all of them
-func (h *hasFactory) DeleteAllApplicationsInASpecificNamespace(namespace string, timeout time.Duration) error {
+// DeleteAllApplicationsInASpecificNamespace removes all application CRs from a specific namespace. Useful when creating a lot of resources and want to remove all of them.
+// Waits up to timeout for the whole batch to be gone; an AppDelete passed through WithTimeout
+// overrides timeout for this call. Additional PostDeleteHooks can be registered
+// via WithPostDelete; they run once per Application after the batch is confirmed gone from the
+// cluster.
+func (h *hasFactory) DeleteAllApplicationsInASpecificNamespace(namespace string, timeout time.Duration, opts ...DeleteOption) error {
+	applicationList := &appservice.ApplicationList{}
+	if err := k8sretry.ListK8sObjectsWithRetry(context.TODO(), h.KubeRest(), applicationList, &rclient.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("error listing applications in namespace %s: %+v", namespace, err)
+	}
+
+	uidMap := make(UIDMap, len(applicationList.Items))
+	for _, app := range applicationList.Items {
+		uidMap[types.NamespacedName{Name: app.Name, Namespace: app.Namespace}] = app.UID
+	}
+
 	if err := h.KubeRest().DeleteAllOf(context.TODO(), &appservice.Application{}, rclient.InNamespace(namespace)); err != nil {
 		return fmt.Errorf("error deleting applications from the namespace %s: %+v", namespace, err)
 	}
 
-	applicationList := &appservice.ApplicationList{}
+	options := newDeleteOptions(opts)
+	cfg := timeouts.Apply(timeouts.Config{AppDelete: timeout}, options.timeoutOpts...)
+	deleteErr := h.WaitForApplicationsDeleted(uidMap, cfg.AppDelete)
+
+	var hookErrs []error
+	if deleteErr == nil {
+		for i := range applicationList.Items {
+			if err := runPostDeleteHooks(h, &applicationList.Items[i], options.postDeleteHooks); err != nil {
+				hookErrs = append(hookErrs, err)
+			}
+		}
+	}
+
+	return combineDeleteAndHookErrors(deleteErr, kerrors.NewAggregate(hookErrs))
+}
+
+// WaitForApplicationsDeleted waits until every Application tracked in uidMap is either gone from the
+// cluster or has been recreated with a different UID than the one captured when the map was built.
+// Lets callers that delete many Applications up front wait once for the whole batch instead of
+// issuing one WaitUntil per Application.
+func (h *hasFactory) WaitForApplicationsDeleted(uidMap UIDMap, timeout time.Duration) error {
 	return utils.WaitUntil(func() (done bool, err error) {
-		if err := h.KubeRest().List(context.Background(), applicationList, &rclient.ListOptions{Namespace: namespace}); err != nil {
-			return false, nil
+		for nn, uid := range uidMap {
+			app, err := h.GetApplication(nn.Name, nn.Namespace)
+			if err != nil {
+				if k8sErrors.IsNotFound(err) {
+					continue
+				}
+				return false, nil
+			}
+			if uid == "" || app.UID == uid {
+				return false, nil
+			}
 		}
-		return len(applicationList.Items) == 0, nil
+		return true, nil
 	}, timeout)
 }
 
@@ -141,7 +247,7 @@ func (h *hasFactory) DeleteAllApplicationsInASpecificNamespace(namespace string,
 func (h *hasFactory) refreshApplicationForErrorDebug(application *appservice.Application) *appservice.Application {
 	retApp := &appservice.Application{}
 
-	if err := h.KubeRest().Get(context.Background(), rclient.ObjectKeyFromObject(application), retApp); err != nil {
+	if err := k8sretry.GetK8sObjectWithRetry(context.Background(), h.KubeRest(), rclient.ObjectKeyFromObject(application), retApp); err != nil {
 		return application
 	}
 
@@ -0,0 +1,98 @@
+package has
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	appservice "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/e2e-tests/pkg/logs"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/k8sretry"
+	rclient "sigs.k8s.io/controller-runtime/pkg/client"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// ResourceDumper implements logs.ResourceDumper for this package, dumping every Application and
+// Component in a namespace as YAML into a logs.ArtifactCollector bundle.
+type ResourceDumper struct {
+	*hasFactory
+}
+
+// NewResourceDumper creates a logs.ResourceDumper for Applications/Components, backed by h.
+// Register it with an ArtifactCollector via NewArtifactCollector or RegisterDumper.
+func NewResourceDumper(h *hasFactory) *ResourceDumper {
+	return &ResourceDumper{h}
+}
+
+// Name identifies this dumper in ArtifactCollector log output.
+func (d *ResourceDumper) Name() string {
+	return "has"
+}
+
+// Dump writes every Application and Component in testNamespace as YAML under
+// dir/has/applications and dir/has/components.
+func (d *ResourceDumper) Dump(testNamespace, dir string) ([]logs.ManifestEntry, error) {
+	var manifest []logs.ManifestEntry
+
+	applicationList := &appservice.ApplicationList{}
+	if err := k8sretry.ListK8sObjectsWithRetry(context.TODO(), d.KubeRest(), applicationList, &rclient.ListOptions{Namespace: testNamespace}); err != nil {
+		return manifest, fmt.Errorf("error listing applications in namespace %s: %+v", testNamespace, err)
+	}
+
+	appDir := filepath.Join(dir, "has", "applications")
+	if err := os.MkdirAll(appDir, os.ModePerm); err != nil {
+		return manifest, err
+	}
+
+	for i := range applicationList.Items {
+		app := &applicationList.Items[i]
+		relPath := filepath.Join("has", "applications", app.Name+".yaml")
+		if err := writeResourceYAML(filepath.Join(dir, relPath), app); err != nil {
+			return manifest, err
+		}
+		manifest = append(manifest, logs.ManifestEntry{
+			Path:      relPath,
+			Kind:      "Application",
+			Name:      app.Name,
+			Namespace: app.Namespace,
+			UID:       string(app.UID),
+		})
+	}
+
+	componentList := &appservice.ComponentList{}
+	if err := k8sretry.ListK8sObjectsWithRetry(context.TODO(), d.KubeRest(), componentList, &rclient.ListOptions{Namespace: testNamespace}); err != nil {
+		return manifest, fmt.Errorf("error listing components in namespace %s: %+v", testNamespace, err)
+	}
+
+	compDir := filepath.Join(dir, "has", "components")
+	if err := os.MkdirAll(compDir, os.ModePerm); err != nil {
+		return manifest, err
+	}
+
+	for i := range componentList.Items {
+		comp := &componentList.Items[i]
+		relPath := filepath.Join("has", "components", comp.Name+".yaml")
+		if err := writeResourceYAML(filepath.Join(dir, relPath), comp); err != nil {
+			return manifest, err
+		}
+		manifest = append(manifest, logs.ManifestEntry{
+			Path:      relPath,
+			Kind:      "Component",
+			Name:      comp.Name,
+			Namespace: comp.Namespace,
+			UID:       string(comp.UID),
+		})
+	}
+
+	return manifest, nil
+}
+
+// writeResourceYAML marshals obj as YAML and writes it to path.
+func writeResourceYAML(path string, obj interface{}) error {
+	data, err := sigsyaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, os.ModePerm)
+}
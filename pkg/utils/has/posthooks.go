@@ -0,0 +1,134 @@
+package has
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appservice "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/timeouts"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	rclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// postDeleteHookTimeout bounds how long a single PostDeleteHook is retried before being reported
+// as failed.
+const postDeleteHookTimeout = 30 * time.Second
+
+// PostDeleteHook runs after an Application has been confirmed gone from the cluster. It receives
+// the last-known state of the deleted Application, captured immediately before the delete call,
+// since the object itself is gone from the API server by the time hooks run.
+type PostDeleteHook func(h *hasFactory, application *appservice.Application) error
+
+// DeleteOption configures a single DeleteApplication/DeleteAllApplicationsInASpecificNamespace call.
+type DeleteOption func(*deleteOptions)
+
+type deleteOptions struct {
+	postDeleteHooks []PostDeleteHook
+	timeoutOpts     []timeouts.Option
+}
+
+func newDeleteOptions(opts []DeleteOption) *deleteOptions {
+	options := &deleteOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithPostDelete registers a PostDeleteHook to run once the Application(s) are confirmed deleted.
+func WithPostDelete(hook PostDeleteHook) DeleteOption {
+	return func(o *deleteOptions) {
+		o.postDeleteHooks = append(o.postDeleteHooks, hook)
+	}
+}
+
+// WithTimeout overrides the timeouts.Config fields used to wait for deletion, e.g.
+// WithTimeout(timeouts.WithAppDelete(5*time.Minute)).
+func WithTimeout(opts ...timeouts.Option) DeleteOption {
+	return func(o *deleteOptions) {
+		o.timeoutOpts = append(o.timeoutOpts, opts...)
+	}
+}
+
+// runPostDeleteHooks runs every hook against application, retrying each individually up to
+// postDeleteHookTimeout, and aggregates every failure instead of stopping at the first one.
+func runPostDeleteHooks(h *hasFactory, application *appservice.Application, hooks []PostDeleteHook) error {
+	var errs []error
+	for _, hook := range hooks {
+		hook := hook
+		if err := utils.WaitUntil(func() (bool, error) {
+			return hook(h, application) == nil, nil
+		}, postDeleteHookTimeout); err != nil {
+			errs = append(errs, fmt.Errorf("post-delete hook failed for application %s/%s: %w", application.Namespace, application.Name, err))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// combineDeleteAndHookErrors reports hookErr without letting it mask deleteErr, the primary result
+// of the delete operation itself.
+func combineDeleteAndHookErrors(deleteErr, hookErr error) error {
+	switch {
+	case deleteErr != nil && hookErr != nil:
+		return fmt.Errorf("%w (additionally, post-delete hooks failed: %s)", deleteErr, hookErr)
+	case deleteErr != nil:
+		return deleteErr
+	default:
+		return hookErr
+	}
+}
+
+// CleanupGitOpsRepo is a built-in PostDeleteHook that removes the GitOps repository in GitHub
+// associated with the deleted Application, derived from its last-known devfile content via
+// utils.ObtainGitOpsRepositoryName.
+func CleanupGitOpsRepo() PostDeleteHook {
+	return func(h *hasFactory, application *appservice.Application) error {
+		if application.Status.Devfile == "" {
+			return nil
+		}
+
+		repoName := utils.ObtainGitOpsRepositoryName(application.Status.Devfile)
+		if !h.Github.CheckIfRepositoryExist(repoName) {
+			return nil
+		}
+
+		return h.Github.DeleteRepository(repoName)
+	}
+}
+
+// CleanupDanglingPipelineRuns is a built-in PostDeleteHook that removes the PipelineRuns and PVCs
+// in the Application's namespace labelled appstudio.openshift.io/application=<application name>.
+func CleanupDanglingPipelineRuns() PostDeleteHook {
+	return func(h *hasFactory, application *appservice.Application) error {
+		matchingApp := rclient.MatchingLabels{"appstudio.openshift.io/application": application.Name}
+
+		if err := h.KubeRest().DeleteAllOf(context.TODO(), &pipelinev1beta1.PipelineRun{}, rclient.InNamespace(application.Namespace), matchingApp); err != nil && !k8sErrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting dangling PipelineRuns for application %s: %+v", application.Name, err)
+		}
+
+		if err := h.KubeRest().DeleteAllOf(context.TODO(), &corev1.PersistentVolumeClaim{}, rclient.InNamespace(application.Namespace), matchingApp); err != nil && !k8sErrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting dangling PVCs for application %s: %+v", application.Name, err)
+		}
+
+		return nil
+	}
+}
+
+// RevokeApplicationTokens is a built-in PostDeleteHook that revokes any tokens/secrets created for
+// the Application's pipelines in its namespace.
+func RevokeApplicationTokens() PostDeleteHook {
+	return func(h *hasFactory, application *appservice.Application) error {
+		matchingApp := rclient.MatchingLabels{"appstudio.openshift.io/application": application.Name}
+
+		if err := h.KubeRest().DeleteAllOf(context.TODO(), &corev1.Secret{}, rclient.InNamespace(application.Namespace), matchingApp); err != nil && !k8sErrors.IsNotFound(err) {
+			return fmt.Errorf("error revoking tokens for application %s: %+v", application.Name, err)
+		}
+
+		return nil
+	}
+}
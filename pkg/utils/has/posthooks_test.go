@@ -0,0 +1,52 @@
+package has
+
+import (
+	"errors"
+	"testing"
+
+	appservice "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+func TestCombineDeleteAndHookErrors(t *testing.T) {
+	deleteErr := errors.New("delete failed")
+	hookErr := errors.New("hook failed")
+
+	if err := combineDeleteAndHookErrors(nil, nil); err != nil {
+		t.Errorf("expected nil when both succeed, got %v", err)
+	}
+
+	if err := combineDeleteAndHookErrors(deleteErr, nil); !errors.Is(err, deleteErr) {
+		t.Errorf("expected the delete error to be returned, got %v", err)
+	}
+
+	if err := combineDeleteAndHookErrors(nil, hookErr); err != hookErr {
+		t.Errorf("expected the hook error to be returned when delete succeeded, got %v", err)
+	}
+
+	err := combineDeleteAndHookErrors(deleteErr, hookErr)
+	if err == nil || !errors.Is(err, deleteErr) {
+		t.Errorf("expected the primary delete error to survive when both fail, got %v", err)
+	}
+}
+
+func TestNewDeleteOptionsAppliesWithPostDelete(t *testing.T) {
+	called := 0
+
+	options := newDeleteOptions([]DeleteOption{
+		WithPostDelete(func(h *hasFactory, application *appservice.Application) error {
+			called++
+			return nil
+		}),
+	})
+
+	if len(options.postDeleteHooks) != 1 {
+		t.Fatalf("expected exactly one registered hook, got %d", len(options.postDeleteHooks))
+	}
+
+	if err := options.postDeleteHooks[0](nil, nil); err != nil {
+		t.Errorf("expected hook to run without error, got %v", err)
+	}
+	if called != 1 {
+		t.Errorf("expected hook to have run once, got %d", called)
+	}
+}
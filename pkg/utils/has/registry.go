@@ -0,0 +1,30 @@
+package has
+
+import (
+	"context"
+
+	appservice "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	kubeCl "github.com/redhat-appstudio/e2e-tests/pkg/apis/kubernetes"
+	"github.com/redhat-appstudio/e2e-tests/pkg/controllers/registry"
+	rclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HealthCheck verifies the Application CRD this controller depends on is installed.
+func (h *hasFactory) HealthCheck(ctx context.Context) error {
+	return h.KubeRest().List(ctx, &appservice.ApplicationList{}, &rclient.ListOptions{Limit: 1})
+}
+
+// hasPlugin registers hasFactory with the controller registry.
+type hasPlugin struct{}
+
+func (hasPlugin) Name() string {
+	return "has"
+}
+
+func (hasPlugin) New(kube *kubeCl.CustomClient) (registry.Controller, error) {
+	return &hasFactory{CustomClient: kube}, nil
+}
+
+func init() {
+	registry.Register(hasPlugin{})
+}
@@ -1,15 +1,44 @@
 package jvmbuildservice
 
 import (
+	"context"
+
 	kubeCl "github.com/redhat-appstudio/e2e-tests/pkg/apis/kubernetes"
+	"github.com/redhat-appstudio/e2e-tests/pkg/controllers/registry"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type JvmbuildserviceController struct {
 	*kubeCl.CustomClient
 }
 
-func NewSuiteControler(kube *kubeCl.CustomClient) (*JvmbuildserviceController, error) {
-	return &JvmbuildserviceController{
-		kube,
-	}, nil
+// jbsConfigListGVK identifies the jvm-build-service CRD this controller depends on; used only to
+// confirm the CRD is installed on the cluster before any test exercises this controller.
+var jbsConfigListGVK = schema.GroupVersionKind{
+	Group:   "jvmbuildservice.io",
+	Version: "v1alpha1",
+	Kind:    "JBSConfigList",
+}
+
+// HealthCheck verifies the jvm-build-service CRDs this controller depends on are installed.
+func (c *JvmbuildserviceController) HealthCheck(ctx context.Context) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(jbsConfigListGVK)
+	return c.KubeRest().List(ctx, list)
+}
+
+// jvmbuildservicePlugin registers the JvmbuildserviceController with the controller registry.
+type jvmbuildservicePlugin struct{}
+
+func (jvmbuildservicePlugin) Name() string {
+	return "jvmbuildservice"
+}
+
+func (jvmbuildservicePlugin) New(kube *kubeCl.CustomClient) (registry.Controller, error) {
+	return &JvmbuildserviceController{kube}, nil
+}
+
+func init() {
+	registry.Register(jvmbuildservicePlugin{})
 }
@@ -0,0 +1,67 @@
+package jvmbuildservice
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/redhat-appstudio/e2e-tests/pkg/logs"
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils/k8sretry"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	rclient "sigs.k8s.io/controller-runtime/pkg/client"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// ResourceDumper implements logs.ResourceDumper for this package, dumping every JBSConfig in a
+// namespace as YAML into a logs.ArtifactCollector bundle.
+type ResourceDumper struct {
+	*JvmbuildserviceController
+}
+
+// NewResourceDumper creates a logs.ResourceDumper for JBSConfigs, backed by c.
+func NewResourceDumper(c *JvmbuildserviceController) *ResourceDumper {
+	return &ResourceDumper{c}
+}
+
+// Name identifies this dumper in ArtifactCollector log output.
+func (d *ResourceDumper) Name() string {
+	return "jvmbuildservice"
+}
+
+// Dump writes every JBSConfig in testNamespace as YAML under dir/jvmbuildservice/jbsconfigs.
+func (d *ResourceDumper) Dump(testNamespace, dir string) ([]logs.ManifestEntry, error) {
+	var manifest []logs.ManifestEntry
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(jbsConfigListGVK)
+	if err := k8sretry.ListK8sObjectsWithRetry(context.TODO(), d.KubeRest(), list, &rclient.ListOptions{Namespace: testNamespace}); err != nil {
+		return manifest, fmt.Errorf("error listing JBSConfigs in namespace %s: %+v", testNamespace, err)
+	}
+
+	configDir := filepath.Join(dir, "jvmbuildservice", "jbsconfigs")
+	if err := os.MkdirAll(configDir, os.ModePerm); err != nil {
+		return manifest, err
+	}
+
+	for _, item := range list.Items {
+		relPath := filepath.Join("jvmbuildservice", "jbsconfigs", item.GetName()+".yaml")
+		data, err := sigsyaml.Marshal(item.Object)
+		if err != nil {
+			return manifest, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, relPath), data, os.ModePerm); err != nil {
+			return manifest, err
+		}
+
+		manifest = append(manifest, logs.ManifestEntry{
+			Path:      relPath,
+			Kind:      "JBSConfig",
+			Name:      item.GetName(),
+			Namespace: item.GetNamespace(),
+			UID:       string(item.GetUID()),
+		})
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,109 @@
+// Package k8sretry provides generic wrappers around the common controller-runtime client
+// operations (Get, List, Create, Delete) that retry on transient API-server errors. Flakes caused
+// by connection resets, 5xx responses, server timeouts or 429 throttling responses should not fail an
+// e2e test outright; callers that used to call the client directly, or wrap a single attempt in
+// utils.WaitUntil, should route through these helpers instead.
+package k8sretry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	rclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// defaultRetryTimeout bounds how long the Eventually block below keeps retrying a single operation.
+const defaultRetryTimeout = 1 * time.Minute
+const defaultRetryInterval = 1 * time.Second
+
+// isRetryableError reports whether err is a transient condition worth retrying: a reset connection,
+// a 5xx/ServerTimeout response from the API server, or a 429 TooManyRequests throttling response.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if k8sErrors.IsServerTimeout(err) || k8sErrors.IsTimeout(err) || k8sErrors.IsServiceUnavailable(err) ||
+		k8sErrors.IsInternalError(err) || k8sErrors.IsTooManyRequests(err) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// GetK8sObjectWithRetry fetches obj via cl.Get, retrying while the error is transient.
+func GetK8sObjectWithRetry(ctx context.Context, cl rclient.Client, key rclient.ObjectKey, obj rclient.Object) error {
+	var lastErr error
+	Eventually(func() error {
+		lastErr = cl.Get(ctx, key, obj)
+		if lastErr != nil && isRetryableError(lastErr) {
+			GinkgoWriter.Printf("retrying Get for %s/%s after transient error: %v\n", key.Namespace, key.Name, lastErr)
+			return lastErr
+		}
+		return nil
+	}, defaultRetryTimeout, defaultRetryInterval).Should(Succeed())
+	return lastErr
+}
+
+// ListK8sObjectsWithRetry lists objects into list via cl.List, retrying while the error is transient.
+func ListK8sObjectsWithRetry(ctx context.Context, cl rclient.Client, list rclient.ObjectList, opts ...rclient.ListOption) error {
+	var lastErr error
+	Eventually(func() error {
+		lastErr = cl.List(ctx, list, opts...)
+		if lastErr != nil && isRetryableError(lastErr) {
+			GinkgoWriter.Printf("retrying List after transient error: %v\n", lastErr)
+			return lastErr
+		}
+		return nil
+	}, defaultRetryTimeout, defaultRetryInterval).Should(Succeed())
+	return lastErr
+}
+
+// CreateK8sObjectWithRetry creates obj via cl.Create, retrying while the error is transient.
+func CreateK8sObjectWithRetry(ctx context.Context, cl rclient.Client, obj rclient.Object, opts ...rclient.CreateOption) error {
+	var lastErr error
+	Eventually(func() error {
+		lastErr = cl.Create(ctx, obj, opts...)
+		if lastErr != nil && isRetryableError(lastErr) {
+			GinkgoWriter.Printf("retrying Create for %s after transient error: %v\n", obj.GetName(), lastErr)
+			return lastErr
+		}
+		return nil
+	}, defaultRetryTimeout, defaultRetryInterval).Should(Succeed())
+	return lastErr
+}
+
+// DeleteK8sObjectWithRetry deletes obj via cl.Delete, retrying while the error is transient.
+func DeleteK8sObjectWithRetry(ctx context.Context, cl rclient.Client, obj rclient.Object, opts ...rclient.DeleteOption) error {
+	var lastErr error
+	Eventually(func() error {
+		lastErr = cl.Delete(ctx, obj, opts...)
+		if lastErr != nil && isRetryableError(lastErr) {
+			GinkgoWriter.Printf("retrying Delete for %s after transient error: %v\n", obj.GetName(), lastErr)
+			return lastErr
+		}
+		return nil
+	}, defaultRetryTimeout, defaultRetryInterval).Should(Succeed())
+	return lastErr
+}
+
+// Do retries fn while it returns a transient error, for callers going through a client that isn't
+// controller-runtime's rclient.Client (e.g. a typed client-go clientset) and so can't use the
+// Get/List/Create/Delete helpers above directly. desc is only used for the retry log line.
+func Do(desc string, fn func() error) error {
+	var lastErr error
+	Eventually(func() error {
+		lastErr = fn()
+		if lastErr != nil && isRetryableError(lastErr) {
+			GinkgoWriter.Printf("retrying %s after transient error: %v\n", desc, lastErr)
+			return lastErr
+		}
+		return nil
+	}, defaultRetryTimeout, defaultRetryInterval).Should(Succeed())
+	return lastErr
+}
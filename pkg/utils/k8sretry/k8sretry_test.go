@@ -0,0 +1,37 @@
+package k8sretry
+
+import (
+	"errors"
+	"testing"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type fakeNetError struct{}
+
+func (fakeNetError) Error() string   { return "fake net error" }
+func (fakeNetError) Timeout() bool   { return true }
+func (fakeNetError) Temporary() bool { return true }
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"plain error", errors.New("boom"), false},
+		{"service unavailable", k8sErrors.NewServiceUnavailable("down for maintenance"), true},
+		{"too many requests", k8sErrors.NewTooManyRequests("slow down", 1), true},
+		{"internal error", k8sErrors.NewInternalError(errors.New("server broke")), true},
+		{"net error", fakeNetError{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err); got != tt.want {
+				t.Errorf("isRetryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Package timeouts centralizes the e2e suite's per-operation timeouts so clusters that are slower
+// than CI can be tuned via environment variables instead of recompiling the suite, while individual
+// calls can still override a single field via functional options where a test genuinely needs to
+// wait longer or shorter than the default.
+package timeouts
+
+import (
+	"time"
+
+	"github.com/redhat-appstudio/e2e-tests/pkg/utils"
+)
+
+// Config holds the default timeout for each tunable operation in the suite. Controller packages
+// beyond has are expected to hang their own fields off this same struct as they adopt it.
+type Config struct {
+	AppCreate       time.Duration
+	AppDelete       time.Duration
+	DevfileReady    time.Duration
+	GitopsRepoReady time.Duration
+}
+
+// defaults mirror the hard-coded values the suite used before timeouts became configurable.
+var defaults = Config{
+	AppCreate:       10 * time.Minute,
+	AppDelete:       1 * time.Minute,
+	DevfileReady:    10 * time.Minute,
+	GitopsRepoReady: 1 * time.Minute,
+}
+
+// NewConfig builds a Config from E2E_TIMEOUT_* environment variables, falling back to defaults for
+// any variable that is unset or fails to parse as a time.Duration (e.g. "10m"). The -e2e-timeout-*
+// flags registered in flags.go, if set, take precedence over both.
+func NewConfig() Config {
+	return applyFlags(Config{
+		AppCreate:       envDuration("E2E_TIMEOUT_APP_CREATE", defaults.AppCreate),
+		AppDelete:       envDuration("E2E_TIMEOUT_APP_DELETE", defaults.AppDelete),
+		DevfileReady:    envDuration("E2E_TIMEOUT_DEVFILE_READY", defaults.DevfileReady),
+		GitopsRepoReady: envDuration("E2E_TIMEOUT_GITOPS_REPO_READY", defaults.GitopsRepoReady),
+	})
+}
+
+func envDuration(key string, fallback time.Duration) time.Duration {
+	raw := utils.GetEnv(key, "")
+	if raw == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// Option overrides a single field of a Config for one call, without touching the suite-wide
+// defaults returned by NewConfig.
+type Option func(*Config)
+
+// WithAppCreate overrides AppCreate for a single call.
+func WithAppCreate(d time.Duration) Option {
+	return func(c *Config) { c.AppCreate = d }
+}
+
+// WithAppDelete overrides AppDelete for a single call.
+func WithAppDelete(d time.Duration) Option {
+	return func(c *Config) { c.AppDelete = d }
+}
+
+// WithDevfileReady overrides DevfileReady for a single call.
+func WithDevfileReady(d time.Duration) Option {
+	return func(c *Config) { c.DevfileReady = d }
+}
+
+// WithGitopsRepoReady overrides GitopsRepoReady for a single call.
+func WithGitopsRepoReady(d time.Duration) Option {
+	return func(c *Config) { c.GitopsRepoReady = d }
+}
+
+// Apply returns a copy of base with every opt applied in order.
+func Apply(base Config, opts ...Option) Config {
+	cfg := base
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
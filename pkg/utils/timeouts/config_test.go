@@ -0,0 +1,39 @@
+package timeouts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEnvDurationFallsBackOnUnsetOrInvalid(t *testing.T) {
+	if d := envDuration("E2E_TIMEOUT_DOES_NOT_EXIST", 5*time.Minute); d != 5*time.Minute {
+		t.Errorf("expected fallback for unset var, got %s", d)
+	}
+
+	t.Setenv("E2E_TIMEOUT_DOES_NOT_EXIST", "not-a-duration")
+	if d := envDuration("E2E_TIMEOUT_DOES_NOT_EXIST", 5*time.Minute); d != 5*time.Minute {
+		t.Errorf("expected fallback for invalid duration, got %s", d)
+	}
+
+	t.Setenv("E2E_TIMEOUT_DOES_NOT_EXIST", "15m")
+	if d := envDuration("E2E_TIMEOUT_DOES_NOT_EXIST", 5*time.Minute); d != 15*time.Minute {
+		t.Errorf("expected parsed env value, got %s", d)
+	}
+}
+
+func TestApply(t *testing.T) {
+	base := Config{AppCreate: 1 * time.Minute, AppDelete: 2 * time.Minute}
+
+	cfg := Apply(base, WithAppCreate(10*time.Minute))
+	if cfg.AppCreate != 10*time.Minute {
+		t.Errorf("expected AppCreate overridden, got %s", cfg.AppCreate)
+	}
+	if cfg.AppDelete != 2*time.Minute {
+		t.Errorf("expected AppDelete untouched, got %s", cfg.AppDelete)
+	}
+
+	// base must not be mutated by Apply.
+	if base.AppCreate != 1*time.Minute {
+		t.Errorf("expected base Config unmodified, got %s", base.AppCreate)
+	}
+}
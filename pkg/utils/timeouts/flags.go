@@ -0,0 +1,42 @@
+package timeouts
+
+import (
+	"flag"
+	"time"
+)
+
+// Duration flags mirroring the E2E_TIMEOUT_* env vars, so a run can override a single timeout
+// without exporting an env var, e.g. `go test ./test/e2e/... -args -e2e-timeout-app-create=15m`.
+// Registered against the standard flag.CommandLine, so whatever entrypoint parses flags for the
+// suite (ginkgo's own flags, `go test`'s, ...) picks these up for free. Zero means "not set".
+var (
+	appCreateFlag       time.Duration
+	appDeleteFlag       time.Duration
+	devfileReadyFlag    time.Duration
+	gitopsRepoReadyFlag time.Duration
+)
+
+func init() {
+	flag.DurationVar(&appCreateFlag, "e2e-timeout-app-create", 0, "Override E2E_TIMEOUT_APP_CREATE for this run (e.g. 10m)")
+	flag.DurationVar(&appDeleteFlag, "e2e-timeout-app-delete", 0, "Override E2E_TIMEOUT_APP_DELETE for this run (e.g. 1m)")
+	flag.DurationVar(&devfileReadyFlag, "e2e-timeout-devfile-ready", 0, "Override E2E_TIMEOUT_DEVFILE_READY for this run (e.g. 10m)")
+	flag.DurationVar(&gitopsRepoReadyFlag, "e2e-timeout-gitops-repo-ready", 0, "Override E2E_TIMEOUT_GITOPS_REPO_READY for this run (e.g. 1m)")
+}
+
+// applyFlags overrides any field in cfg whose matching -e2e-timeout-* flag was set to a non-zero
+// duration. Flags take precedence over the E2E_TIMEOUT_* env vars NewConfig already read.
+func applyFlags(cfg Config) Config {
+	if appCreateFlag != 0 {
+		cfg.AppCreate = appCreateFlag
+	}
+	if appDeleteFlag != 0 {
+		cfg.AppDelete = appDeleteFlag
+	}
+	if devfileReadyFlag != 0 {
+		cfg.DevfileReady = devfileReadyFlag
+	}
+	if gitopsRepoReadyFlag != 0 {
+		cfg.GitopsRepoReady = gitopsRepoReadyFlag
+	}
+	return cfg
+}